@@ -2,7 +2,6 @@ package main
 
 import (
 	"bytes"
-	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
@@ -13,17 +12,13 @@ import (
 	"github.com/apache/arrow-go/v18/arrow"
 	ac "github.com/apache/arrow-go/v18/arrow/csv"
 	json2schema "github.com/takanoriyanagitani/go-arrow-json2csv2schema"
+	"github.com/takanoriyanagitani/go-arrow-json2csv2schema/schemaencoder"
+	"github.com/takanoriyanagitani/go-arrow-json2csv2schema/schemahints"
 )
 
-type SerializableField struct {
-	Name     string `json:"name"`
-	Type     string `json:"type"`
-	Nullable bool   `json:"nullable"`
-}
-
-type SerializableSchema struct {
-	Fields []SerializableField `json:"fields"`
-}
+// defaultFormat is the plain fmt.Stringer rendering of *arrow.Schema,
+// kept for backward compatibility with versions predating -format.
+const defaultFormat = "schema"
 
 type cli struct {
 	Input    io.ReadCloser
@@ -47,12 +42,29 @@ func main() {
 const expectedTypeMappingParts = 2
 
 func (cliApp *cli) run() int {
-	pretty, includeColumns, columnTypes, inputFile, outputFile, err := cliApp.parseFlags()
+	format, includeColumns, columnTypes, inputFile, outputFile, hintsFile, flatten, err := cliApp.parseFlags()
 	if err != nil {
 		_, _ = fmt.Fprintln(cliApp.Output, err)
 		return 1
 	}
 
+	hints, err := loadHints(hintsFile)
+	if err != nil {
+		_, _ = fmt.Fprintf(cliApp.Output, "Error loading hints file: %v\n", err)
+		return 1
+	}
+
+	hintedTypes, err := hints.ToColumnTypes()
+	if err != nil {
+		_, _ = fmt.Fprintf(cliApp.Output, "Error: %v\n", err)
+		return 1
+	}
+	for name, dt := range hintedTypes {
+		if _, explicit := columnTypes[name]; !explicit {
+			columnTypes[name] = dt
+		}
+	}
+
 	err = cliApp.openInput(inputFile)
 	if err != nil {
 		_, _ = fmt.Fprintf(cliApp.Output, "Error opening input file: %v\n", err)
@@ -81,7 +93,7 @@ func (cliApp *cli) run() int {
 
 	opts := createOptions(includeColumns, columnTypes)
 
-	err = run(cliApp.Input, cliApp.Output, pretty, opts)
+	err = run(cliApp.Input, cliApp.Output, format, opts, hints, flatten)
 	if err != nil {
 		_, _ = fmt.Fprintf(cliApp.Output, "Error: %v\n", err)
 		return 1
@@ -90,25 +102,53 @@ func (cliApp *cli) run() int {
 	return cliApp.ExitCode
 }
 
-func (cliApp *cli) parseFlags() (bool, []string, map[string]arrow.DataType, string, string, error) {
+func (cliApp *cli) parseFlags() (string, []string, map[string]arrow.DataType, string, string, string, bool, error) {
 	var inputFile string
 	var outputFile string
 	var pretty bool
+	var format string
 	var include string
 	var types string
+	var hintsFile string
+	var flatten bool
 
 	flagSet := flag.NewFlagSet("arrow-json2csv2schema", flag.ContinueOnError)
 	flagSet.StringVar(&inputFile, "input", "", "Input JSON file (default: stdin)")
 	flagSet.StringVar(&inputFile, "i", "", "Input JSON file (shorthand)")
 	flagSet.StringVar(&outputFile, "output", "", "Output file (default: stdout)")
 	flagSet.StringVar(&outputFile, "o", "", "Output file (shorthand)")
-	flagSet.BoolVar(&pretty, "pretty", false, "Pretty print the schema")
+	flagSet.BoolVar(&pretty, "pretty", false, "Pretty print the schema (shorthand for -format=json)")
+	flagSet.StringVar(
+		&format,
+		"format",
+		"",
+		"Output format: schema, json, ipc, parquet, json-schema (default: schema, or json if -pretty is set)",
+	)
 	flagSet.StringVar(&include, "include", "", "Comma-separated list of columns to include")
 	flagSet.StringVar(&types, "types", "", "Comma-separated list of column_name:type pairs")
+	flagSet.StringVar(
+		&hintsFile,
+		"hints",
+		"",
+		"Schema hints JSON file (type/format/precision/scale/nullable/required/enum/rename per field)",
+	)
+	flagSet.BoolVar(
+		&flatten,
+		"flatten",
+		false,
+		"Flatten nested objects/arrays into dotted/bracketed columns (user.city, tags[0]) instead of treating them as opaque JSON strings",
+	)
 
 	err := flagSet.Parse(cliApp.Args)
 	if err != nil {
-		return false, nil, nil, "", "", err
+		return "", nil, nil, "", "", "", false, err
+	}
+
+	if "" == format {
+		format = defaultFormat
+		if pretty {
+			format = "json"
+		}
 	}
 
 	includeColumns := []string{}
@@ -122,13 +162,29 @@ func (cliApp *cli) parseFlags() (bool, []string, map[string]arrow.DataType, stri
 		for _, pair := range pairs {
 			kv := strings.Split(pair, ":")
 			if len(kv) != expectedTypeMappingParts {
-				return false, nil, nil, "", "", fmt.Errorf("%w: %s", ErrInvalidTypeMapping, pair)
+				return "", nil, nil, "", "", "", false, fmt.Errorf("%w: %s", ErrInvalidTypeMapping, pair)
 			}
 			columnTypes[kv[0]] = typeFromString(kv[1])
 		}
 	}
 
-	return pretty, includeColumns, columnTypes, inputFile, outputFile, nil
+	return format, includeColumns, columnTypes, inputFile, outputFile, hintsFile, flatten, nil
+}
+
+// loadHints reads hintsFile if set, returning a zero-value Document (no
+// hints) when it isn't.
+func loadHints(hintsFile string) (schemahints.Document, error) {
+	if "" == hintsFile {
+		return schemahints.Document{}, nil
+	}
+
+	file, err := os.Open(hintsFile) //nolint:gosec
+	if err != nil {
+		return schemahints.Document{}, err
+	}
+	defer file.Close()
+
+	return schemahints.Load(file)
 }
 
 func (cliApp *cli) openInput(inputFile string) error {
@@ -177,91 +233,52 @@ func typeFromString(s string) arrow.DataType {
 	}
 }
 
-func toSerializableSchema(schema *arrow.Schema) *SerializableSchema {
-	serializableSchema := &SerializableSchema{
-		Fields: make([]SerializableField, schema.NumFields()),
+// run accepts a single JSON object, a JSON array of objects, or NDJSON on
+// r, infers a schema that reflects every row it finds rather than just the
+// first, applies hints for anything the CSV reader couldn't express
+// directly (rename, drop, nullable/required, enum), and writes the result
+// in the requested format. When flatten is set, nested objects/arrays are
+// flattened into dotted/bracketed columns instead of opaque JSON strings.
+func run(r io.Reader, writer io.Writer, format string, opts []ac.Option, hints schemahints.Document, flatten bool) error {
+	strategy := json2schema.MapToStrings{
+		MapToHeaderStrings: json2schema.MapToHeaderStrsSorted,
+		MapToValueStrings:  json2schema.MapToValueStrsSorted,
 	}
-
-	for idx, field := range schema.Fields() {
-		switch field.Type.(type) {
-		case *arrow.Float64Type:
-			serializableSchema.Fields[idx] = SerializableField{
-				Name:     field.Name,
-				Type:     "float64",
-				Nullable: field.Nullable,
-			}
-		case *arrow.StringType:
-			serializableSchema.Fields[idx] = SerializableField{
-				Name:     field.Name,
-				Type:     "utf8",
-				Nullable: field.Nullable,
-			}
-		case *arrow.BooleanType:
-			serializableSchema.Fields[idx] = SerializableField{
-				Name:     field.Name,
-				Type:     "bool",
-				Nullable: field.Nullable,
-			}
-		default:
-			serializableSchema.Fields[idx] = SerializableField{
-				Name:     field.Name,
-				Type:     field.Type.Name(),
-				Nullable: field.Nullable,
-			}
-		}
+	if flatten {
+		strategy = json2schema.NewMapToStringsFlattened(json2schema.DefaultFlattenOptions())
 	}
+	j2c := strategy.ToJsonStreamToCsv()
 
-	return serializableSchema
-}
-
-func run(r io.Reader, writer io.Writer, pretty bool, opts []ac.Option) error {
-	jsonBytes, err := io.ReadAll(r)
+	csvData, err := j2c(r)
 	if nil != err {
 		return err
 	}
 
-	var j2c json2schema.JsonToCsvRaw = json2schema.MapToCsv{
-		MapToHeaderLine: json2schema.MapToStrings{
-			MapToHeaderStrings: json2schema.MapToHeaderStrsSorted,
-			MapToValueStrings:  json2schema.MapToValueStrsSorted,
-		}.ToMapToHeaderLine(),
-		MapToRow1st: json2schema.MapToStrings{
-			MapToHeaderStrings: json2schema.MapToHeaderStrsSorted,
-			MapToValueStrings:  json2schema.MapToValueStrsSorted,
-		}.ToMapToRow1st(),
-	}.ToJsonToCsvRaw()
-
-	csvData, err := j2c(jsonBytes)
+	var buf bytes.Buffer
+	_, err = buf.Write(csvData.HeaderLine)
 	if nil != err {
 		return err
 	}
 
-	var buf bytes.Buffer
-	_, err = buf.Write(csvData.HeaderLine)
+	_, err = buf.Write(csvData.Row1st)
 	if nil != err {
 		return err
 	}
 
-	_, err = buf.Write(csvData.Row1st)
+	schema, err := json2schema.CsvForSchemaRowsWithHeader(buf.Bytes()).ToSchema(opts...)
 	if nil != err {
 		return err
 	}
 
-	schema, err := json2schema.CsvForSchemaRow1stWithHeader(buf.Bytes()).ToSchema(opts...)
+	schema, err = hints.Apply(schema)
 	if nil != err {
 		return err
 	}
 
-	if pretty {
-		serializableSchema := toSerializableSchema(schema)
-		jsonBytes, err := json.MarshalIndent(serializableSchema, "", "  ")
-		if err != nil {
-			return err
-		}
-		_, err = writer.Write(jsonBytes)
+	if defaultFormat == format {
+		_, err = fmt.Fprintln(writer, schema)
 		return err
 	}
 
-	_, err = fmt.Fprintln(writer, schema)
-	return err
+	return schemaencoder.NewRegistry().Encode(format, schema, writer)
 }
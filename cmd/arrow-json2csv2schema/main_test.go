@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/takanoriyanagitani/go-arrow-json2csv2schema/schemahints"
+)
+
+func TestRunFlattenProducesNestedColumns(t *testing.T) {
+	input := `{"user":{"name":"alice","age":30}}`
+
+	var out bytes.Buffer
+	err := run(strings.NewReader(input), &out, "schema", nil, schemahints.Document{}, true)
+	if nil != err {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	schema := out.String()
+	if !strings.Contains(schema, "user.name") || !strings.Contains(schema, "user.age") {
+		t.Fatalf("expected flattened columns user.name/user.age, got %q", schema)
+	}
+}
+
+func TestRunWithoutFlattenKeepsNestedAsString(t *testing.T) {
+	input := `{"user":{"name":"alice","age":30}}`
+
+	var out bytes.Buffer
+	err := run(strings.NewReader(input), &out, "schema", nil, schemahints.Document{}, false)
+	if nil != err {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	schema := out.String()
+	if strings.Contains(schema, "user.name") {
+		t.Fatalf("did not expect flattened columns without -flatten, got %q", schema)
+	}
+}
@@ -1,6 +1,7 @@
 package json2schema
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/csv"
 	"encoding/json"
@@ -20,6 +21,8 @@ import (
 var (
 	ErrNoCsvRecordBatchGot error = errors.New("no csv record batch got")
 	ErrKeyNotFound         error = errors.New("key not found")
+	ErrNoJsonObjectsGot    error = errors.New("no json objects got")
+	ErrHeaderValueMismatch error = errors.New("header/value count mismatch")
 )
 
 type JsonRawObject []byte
@@ -36,19 +39,65 @@ type JsonMapObject map[string]any
 type MapToHeaderLine func(JsonMapObject) ([]byte, error)
 type MapToRow1st func(JsonMapObject) ([]byte, error)
 
+// JsonDecoder abstracts the JSON parsing backend that turns raw bytes into
+// a JsonMapObject, so callers can plug in a faster parser (jsoniter, sonic,
+// simdjson-go, ...) without touching MapToCsv's CSV-building logic.
+type JsonDecoder interface {
+	Decode(JsonRawObject) (JsonMapObject, error)
+}
+
+// JsonDecoderFunc adapts a plain function to a JsonDecoder.
+type JsonDecoderFunc func(JsonRawObject) (JsonMapObject, error)
+
+func (f JsonDecoderFunc) Decode(j JsonRawObject) (JsonMapObject, error) {
+	return f(j)
+}
+
+// StdJsonDecoder is the package's long-standing default: encoding/json.
+var StdJsonDecoder JsonDecoder = JsonDecoderFunc(func(j JsonRawObject) (JsonMapObject, error) {
+	jmap := JsonMapObject{}
+	e := json.Unmarshal(j, &jmap)
+	return jmap, e
+})
+
+// JsonStreamDecoder is the streaming counterpart of JsonDecoder: it reads
+// every JsonMapObject out of r instead of unmarshalling a single blob.
+type JsonStreamDecoder interface {
+	DecodeStream(r io.Reader) ([]JsonMapObject, error)
+}
+
+// JsonStreamDecoderFunc adapts a plain function to a JsonStreamDecoder.
+type JsonStreamDecoderFunc func(io.Reader) ([]JsonMapObject, error)
+
+func (f JsonStreamDecoderFunc) DecodeStream(r io.Reader) ([]JsonMapObject, error) {
+	return f(r)
+}
+
+// StdJsonStreamDecoder is the package's long-standing default: NDJSON / a
+// JSON array decoded via encoding/json.
+var StdJsonStreamDecoder JsonStreamDecoder = JsonStreamDecoderFunc(decodeJsonMapObjects)
+
 type MapToCsv struct {
 	MapToHeaderLine
 	MapToRow1st
+
+	// Decoder parses the raw JSON object. A nil Decoder falls back to
+	// StdJsonDecoder, so existing MapToCsv{...} literals keep working.
+	Decoder JsonDecoder
+}
+
+func (m MapToCsv) decoder() JsonDecoder {
+	if nil != m.Decoder {
+		return m.Decoder
+	}
+	return StdJsonDecoder
 }
 
 func (m MapToCsv) ToJsonToCsvRaw() JsonToCsvRaw {
 	return func(j JsonRawObject) (CsvForSchema, error) {
 		var empty CsvForSchema
 
-		var jbytes []byte = j
-		jmap := JsonMapObject{}
-
-		e := json.Unmarshal(jbytes, &jmap)
+		jmap, e := m.decoder().Decode(j)
 		if nil != e {
 			return empty, e
 		}
@@ -63,6 +112,237 @@ func (m MapToCsv) ToJsonToCsvRaw() JsonToCsvRaw {
 	}
 }
 
+// JsonStreamToCsv converts a stream of JSON objects read from r - either a
+// JSON array of objects or newline-delimited JSON (NDJSON) - into a single
+// CSV document: one header line followed by one row per object.
+type JsonStreamToCsv func(r io.Reader) (CsvForSchema, error)
+
+// peekIsJsonArray reports whether the next non-whitespace byte of br opens
+// a JSON array, without consuming anything else.
+func peekIsJsonArray(br *bufio.Reader) (bool, error) {
+	for {
+		b, e := br.Peek(1)
+		if nil != e {
+			return false, e
+		}
+
+		switch b[0] {
+		case ' ', '\t', '\n', '\r':
+			_, e := br.Discard(1)
+			if nil != e {
+				return false, e
+			}
+		case '[':
+			return true, nil
+		default:
+			return false, nil
+		}
+	}
+}
+
+// decodeJsonMapObjects reads every JsonMapObject out of r, accepting
+// either a single JSON array or a stream of NDJSON objects.
+func decodeJsonMapObjects(r io.Reader) ([]JsonMapObject, error) {
+	br := bufio.NewReader(r)
+
+	isArray, e := peekIsJsonArray(br)
+	if nil != e && !errors.Is(e, io.EOF) {
+		return nil, e
+	}
+
+	dec := json.NewDecoder(br)
+
+	if isArray {
+		var objs []JsonMapObject
+		e := dec.Decode(&objs)
+		if nil != e {
+			return nil, e
+		}
+		return objs, nil
+	}
+
+	var objs []JsonMapObject
+	for {
+		var jmap JsonMapObject
+		e := dec.Decode(&jmap)
+		if errors.Is(e, io.EOF) {
+			break
+		}
+		if nil != e {
+			return nil, e
+		}
+		objs = append(objs, jmap)
+	}
+
+	return objs, nil
+}
+
+// decodeJsonRawObjects reads every top-level JSON value out of r - either
+// a single JSON array or a stream of NDJSON values - as raw, undecoded
+// bytes. This lets JsonDecoderStream hand each value to an arbitrary
+// JsonDecoder instead of always going through encoding/json.
+func decodeJsonRawObjects(r io.Reader) ([]JsonRawObject, error) {
+	br := bufio.NewReader(r)
+
+	isArray, e := peekIsJsonArray(br)
+	if nil != e && !errors.Is(e, io.EOF) {
+		return nil, e
+	}
+
+	dec := json.NewDecoder(br)
+
+	if isArray {
+		var raws []json.RawMessage
+		e := dec.Decode(&raws)
+		if nil != e {
+			return nil, e
+		}
+		objs := make([]JsonRawObject, len(raws))
+		for i, raw := range raws {
+			objs[i] = JsonRawObject(raw)
+		}
+		return objs, nil
+	}
+
+	var objs []JsonRawObject
+	for {
+		var raw json.RawMessage
+		e := dec.Decode(&raw)
+		if errors.Is(e, io.EOF) {
+			break
+		}
+		if nil != e {
+			return nil, e
+		}
+		objs = append(objs, JsonRawObject(raw))
+	}
+
+	return objs, nil
+}
+
+// JsonDecoderStream adapts any JsonDecoder into a JsonStreamDecoder: it
+// splits r (a JSON array or NDJSON) into individual raw JSON values and
+// decodes each one with dec. This is what lets a single-object backend
+// such as SimdJsonDecoder drive the streaming pipeline main.run actually
+// calls, rather than only the abandoned MapToCsv.ToJsonToCsvRaw path.
+func JsonDecoderStream(dec JsonDecoder) JsonStreamDecoder {
+	return JsonStreamDecoderFunc(func(r io.Reader) ([]JsonMapObject, error) {
+		raws, e := decodeJsonRawObjects(r)
+		if nil != e {
+			return nil, e
+		}
+
+		objs := make([]JsonMapObject, len(raws))
+		for i, raw := range raws {
+			jmap, e := dec.Decode(raw)
+			if nil != e {
+				return nil, e
+			}
+			objs[i] = jmap
+		}
+		return objs, nil
+	})
+}
+
+// ToJsonStreamToCsv builds a JsonStreamToCsv using StdJsonStreamDecoder.
+// See ToJsonStreamToCsvUsing to plug in a different JsonStreamDecoder.
+func (m MapToStrings) ToJsonStreamToCsv() JsonStreamToCsv {
+	return m.ToJsonStreamToCsvUsing(StdJsonStreamDecoder)
+}
+
+// ToJsonStreamToCsvUsing builds a JsonStreamToCsv that uses dec to decode
+// r, then writes every object against the union of all objects' columns
+// (in first-seen order), padding any column an object omits with an empty
+// field - rather than each object's own local key set - so heterogeneous
+// rows line up under one header and missing columns surface as nullable
+// once CsvForSchemaRowsWithHeader.ToSchema sees the blank fields.
+func (m MapToStrings) ToJsonStreamToCsvUsing(dec JsonStreamDecoder) JsonStreamToCsv {
+	return func(r io.Reader) (CsvForSchema, error) {
+		var empty CsvForSchema
+
+		objs, e := dec.DecodeStream(r)
+		if nil != e {
+			return empty, e
+		}
+		if 0 == len(objs) {
+			return empty, ErrNoJsonObjectsGot
+		}
+
+		var header []string
+		seen := make(map[string]struct{})
+		rows := make([]map[string]string, len(objs))
+
+		for i, obj := range objs {
+			keys, e := m.MapToHeaderStrings(obj)
+			if nil != e {
+				return empty, e
+			}
+			vals, e := m.MapToValueStrings(obj)
+			if nil != e {
+				return empty, e
+			}
+			if len(keys) != len(vals) {
+				return empty, ErrHeaderValueMismatch
+			}
+
+			row := make(map[string]string, len(keys))
+			for idx, k := range keys {
+				row[k] = vals[idx]
+				if _, ok := seen[k]; !ok {
+					seen[k] = struct{}{}
+					header = append(header, k)
+				}
+			}
+			rows[i] = row
+		}
+
+		hline, e := csvLine(header)
+		if nil != e {
+			return empty, e
+		}
+
+		var rowBuf bytes.Buffer
+		for _, row := range rows {
+			vals := make([]string, len(header))
+			for i, k := range header {
+				vals[i] = row[k]
+			}
+
+			line, e := csvLine(vals)
+			if nil != e {
+				return empty, e
+			}
+			_, e = rowBuf.Write(line)
+			if nil != e {
+				return empty, e
+			}
+		}
+
+		return CsvForSchema{
+			HeaderLine: hline,
+			Row1st:     rowBuf.Bytes(),
+		}, nil
+	}
+}
+
+// csvLine writes fields as a single CSV record.
+func csvLine(fields []string) ([]byte, error) {
+	var buf bytes.Buffer
+	var wtr *csv.Writer = csv.NewWriter(&buf)
+
+	e := wtr.Write(fields)
+	if nil != e {
+		return nil, e
+	}
+
+	wtr.Flush()
+	if nil != wtr.Error() {
+		return nil, wtr.Error()
+	}
+
+	return buf.Bytes(), nil
+}
+
 type MapToHeaderStrings func(JsonMapObject) ([]string, error)
 type MapToValueStrings func(JsonMapObject) ([]string, error)
 
@@ -78,7 +358,10 @@ func val2str(val any) (string, error) {
 	case string:
 		return typedVal, nil
 	case float64:
-		return fmt.Sprintf("%f", typedVal), nil
+		// FormatFloat with -1 precision renders "1" rather than
+		// "1.000000", so hinted integer columns (schemahints' "int64")
+		// round-trip through strconv.ParseInt instead of failing.
+		return strconv.FormatFloat(typedVal, 'f', -1, 64), nil
 	case bool:
 		return strconv.FormatBool(typedVal), nil
 	default:
@@ -168,6 +451,155 @@ func (m MapToStrings) ToMapToHeaderLine() MapToHeaderLine {
 	}
 }
 
+// FlattenOptions controls how nested maps/arrays are flattened into
+// dotted / bracketed column paths by MapToHeaderStrsFlattened and
+// MapToValueStrsFlattened.
+type FlattenOptions struct {
+	// Separator joins a parent path and a nested object key (e.g. ".").
+	Separator string
+
+	// ArrayIndexOpen/ArrayIndexClose wrap an array index (e.g. "[" / "]").
+	ArrayIndexOpen  string
+	ArrayIndexClose string
+
+	// MaxDepth bounds how many levels of nesting get flattened; anything
+	// deeper is kept as-is and turned into a single JSON-encoded leaf by
+	// val2str.
+	MaxDepth int
+}
+
+// DefaultFlattenOptions returns the conventional dotted/bracketed notation
+// (user.address.city, tags[0], items[2].price) with a generous depth limit.
+func DefaultFlattenOptions() FlattenOptions {
+	return FlattenOptions{
+		Separator:       ".",
+		ArrayIndexOpen:  "[",
+		ArrayIndexClose: "]",
+		MaxDepth:        32,
+	}
+}
+
+type flatEntry struct {
+	Path  string
+	Value any
+}
+
+func flattenValue(
+	path string,
+	val any,
+	opts FlattenOptions,
+	depth int,
+	out *[]flatEntry,
+) error {
+	if depth >= opts.MaxDepth {
+		*out = append(*out, flatEntry{Path: path, Value: val})
+		return nil
+	}
+
+	switch typedVal := val.(type) {
+	case JsonMapObject:
+		return flattenMap(path, typedVal, opts, depth, out)
+	case map[string]any:
+		return flattenMap(path, JsonMapObject(typedVal), opts, depth, out)
+	case []any:
+		for idx, item := range typedVal {
+			childPath := fmt.Sprintf(
+				"%s%s%d%s",
+				path,
+				opts.ArrayIndexOpen,
+				idx,
+				opts.ArrayIndexClose,
+			)
+			e := flattenValue(childPath, item, opts, depth+1, out)
+			if nil != e {
+				return e
+			}
+		}
+		return nil
+	default:
+		*out = append(*out, flatEntry{Path: path, Value: val})
+		return nil
+	}
+}
+
+func flattenMap(
+	prefix string,
+	j JsonMapObject,
+	opts FlattenOptions,
+	depth int,
+	out *[]flatEntry,
+) error {
+	keys, _ := MapToHeaderStrsSorted(j)
+	for _, k := range keys {
+		childPath := k
+		if "" != prefix {
+			childPath = prefix + opts.Separator + k
+		}
+		e := flattenValue(childPath, j[k], opts, depth+1, out)
+		if nil != e {
+			return e
+		}
+	}
+	return nil
+}
+
+// flatten walks j depth-first, visiting keys in sorted order at each
+// level, so the resulting entry order is stable across calls.
+func flatten(j JsonMapObject, opts FlattenOptions) ([]flatEntry, error) {
+	var out []flatEntry
+	e := flattenMap("", j, opts, 0, &out)
+	return out, e
+}
+
+// MapToHeaderStrsFlattened builds a MapToHeaderStrings that recursively
+// flattens nested objects and arrays into dotted / bracketed column paths
+// (e.g. user.address.city, tags[0], items[2].price) instead of treating
+// them as opaque JSON strings.
+func MapToHeaderStrsFlattened(opts FlattenOptions) MapToHeaderStrings {
+	return func(j JsonMapObject) ([]string, error) {
+		entries, e := flatten(j, opts)
+		if nil != e {
+			return nil, e
+		}
+
+		headers := make([]string, len(entries))
+		for i, entry := range entries {
+			headers[i] = entry.Path
+		}
+		return headers, nil
+	}
+}
+
+// MapToValueStrsFlattened builds a MapToValueStrings matching the column
+// order produced by MapToHeaderStrsFlattened with the same opts.
+func MapToValueStrsFlattened(opts FlattenOptions) MapToValueStrings {
+	return func(j JsonMapObject) ([]string, error) {
+		entries, e := flatten(j, opts)
+		if nil != e {
+			return nil, e
+		}
+
+		vals := make([]string, len(entries))
+		for i, entry := range entries {
+			s, e := val2str(entry.Value)
+			if nil != e {
+				return nil, e
+			}
+			vals[i] = s
+		}
+		return vals, nil
+	}
+}
+
+// NewMapToStringsFlattened composes MapToHeaderStrsFlattened and
+// MapToValueStrsFlattened into a single MapToStrings using opts.
+func NewMapToStringsFlattened(opts FlattenOptions) MapToStrings {
+	return MapToStrings{
+		MapToHeaderStrings: MapToHeaderStrsFlattened(opts),
+		MapToValueStrings:  MapToValueStrsFlattened(opts),
+	}
+}
+
 type CsvForSchemaRow1stWithHeader []byte
 
 func (c CsvForSchemaRow1stWithHeader) ToReader(opts ...ac.Option) *ac.Reader {
@@ -186,9 +618,252 @@ func (c CsvForSchemaRow1stWithHeader) ToSchema(
 	defer rdr.Release()
 
 	for rdr.Next() {
-		rec := rdr.RecordBatch()
+		rec := rdr.Record()
+
+		// A parse failure (e.g. a WithColumnTypes hint that doesn't fit
+		// the row's value) lands in r.err synchronously during this same
+		// Next() call, not on some later one - check it before returning
+		// rec's schema, or the failure never surfaces.
+		if e := rdr.Err(); nil != e {
+			return nil, e
+		}
+
 		return rec.Schema(), nil
 	}
 
+	if e := rdr.Err(); nil != e {
+		return nil, e
+	}
+
 	return nil, ErrNoCsvRecordBatchGot
 }
+
+// CsvForSchemaRowsWithHeader is a full CSV document - one header line
+// followed by every data row - as opposed to CsvForSchemaRow1stWithHeader,
+// which only ever carries a single row. ToSchema infers each row's type
+// independently and unifies them, so the result reflects the whole input
+// rather than just row 1.
+type CsvForSchemaRowsWithHeader []byte
+
+func (c CsvForSchemaRowsWithHeader) ToReader(opts ...ac.Option) *ac.Reader {
+	var rdr io.Reader = bytes.NewReader(c)
+	var allOpts []ac.Option = append([]ac.Option{ac.WithHeader(true)}, opts...)
+	return ac.NewInferringReader(
+		rdr,
+		allOpts...,
+	)
+}
+
+// ToSchema infers a schema per data row and unifies them, so fields that
+// only widen or appear partway through the input still end up with the
+// right type and nullability.
+//
+// This can't be done by simply reading the whole document through a
+// single ac.NewInferringReader: regardless of chunking, that reader
+// locks each column's type in from the first row it ever sees (see
+// Reader.validate in arrow/csv) and never revisits it, so every
+// rec.Schema() it ever returns is the same object - there would be
+// nothing left to unify, and a later row that doesn't fit the type row 1
+// picked (e.g. an int64 column later seeing "1.5") would hard-fail
+// instead of widening. Building a fresh single-row reader per row - via
+// CsvForSchemaRow1stWithHeader, so column-type hints and column filters
+// in opts still apply exactly as they would to a full read - sidesteps
+// that by construction.
+func (c CsvForSchemaRowsWithHeader) ToSchema(
+	opts ...ac.Option,
+) (*arrow.Schema, error) {
+	header, rows, e := splitCsvRows(c)
+	if nil != e {
+		return nil, e
+	}
+
+	var unified *arrow.Schema
+	nullable := make(map[string]bool, len(header))
+	hasValue := make(map[string]bool, len(header))
+
+	for _, row := range rows {
+		for i, name := range header {
+			if "" == row[i] {
+				nullable[name] = true
+			} else {
+				hasValue[name] = true
+			}
+		}
+
+		// A blank cell means "this row has no value for this column",
+		// not "this column is a string" - ac.NewInferringReader would
+		// infer string for "" (nothing else parses), which would wrongly
+		// widen e.g. an int64 column to string the moment any row omits
+		// it. Drop blank cells before inferring and fold them back in as
+		// nullable below instead.
+		rowHeader, rowVals := nonEmptyColumns(header, row)
+		if 0 == len(rowHeader) {
+			continue
+		}
+
+		schema, e := rowSchema(rowHeader, rowVals, opts...)
+		if nil != e {
+			return nil, e
+		}
+
+		if nil == unified {
+			unified = schema
+			continue
+		}
+
+		unified = UnifySchemas(unified, schema)
+	}
+
+	if nil == unified {
+		return nil, ErrNoCsvRecordBatchGot
+	}
+
+	fields := make([]arrow.Field, 0, unified.NumFields())
+	seen := make(map[string]bool, unified.NumFields())
+	for _, f := range unified.Fields() {
+		f.Nullable = f.Nullable || nullable[f.Name]
+		fields = append(fields, f)
+		seen[f.Name] = true
+	}
+
+	// A column that's blank in every row never reaches rowSchema (there's
+	// no value to infer a type from) and so never makes it into unified,
+	// but it's still a real header column - keep it as a nullable string
+	// field instead of silently dropping it from the schema.
+	for _, name := range header {
+		if seen[name] || hasValue[name] {
+			continue
+		}
+		fields = append(fields, arrow.Field{Name: name, Type: arrow.BinaryTypes.String, Nullable: true})
+	}
+
+	return arrow.NewSchema(fields, nil), nil
+}
+
+// splitCsvRows parses a CSV document's header line and data rows as raw
+// strings, so ToSchema can infer each row's schema in isolation.
+func splitCsvRows(c CsvForSchemaRowsWithHeader) ([]string, [][]string, error) {
+	rdr := csv.NewReader(bytes.NewReader(c))
+
+	header, e := rdr.Read()
+	if nil != e {
+		return nil, nil, e
+	}
+
+	var rows [][]string
+	for {
+		row, e := rdr.Read()
+		if errors.Is(e, io.EOF) {
+			break
+		}
+		if nil != e {
+			return nil, nil, e
+		}
+		rows = append(rows, row)
+	}
+
+	return header, rows, nil
+}
+
+// nonEmptyColumns drops the columns in row whose value is blank, keeping
+// header and row aligned.
+func nonEmptyColumns(header, row []string) ([]string, []string) {
+	keptHeader := make([]string, 0, len(header))
+	keptRow := make([]string, 0, len(row))
+	for i, name := range header {
+		if "" == row[i] {
+			continue
+		}
+		keptHeader = append(keptHeader, name)
+		keptRow = append(keptRow, row[i])
+	}
+	return keptHeader, keptRow
+}
+
+// rowSchema infers the schema of a single CSV row by feeding it through
+// CsvForSchemaRow1stWithHeader.ToSchema as a standalone header+row
+// document, so opts (column-type hints, column filters) apply to it the
+// same way they would to a full multi-row read.
+func rowSchema(header, row []string, opts ...ac.Option) (*arrow.Schema, error) {
+	var buf bytes.Buffer
+	wtr := csv.NewWriter(&buf)
+	if e := wtr.Write(header); nil != e {
+		return nil, e
+	}
+	if e := wtr.Write(row); nil != e {
+		return nil, e
+	}
+	wtr.Flush()
+	if e := wtr.Error(); nil != e {
+		return nil, e
+	}
+
+	return CsvForSchemaRow1stWithHeader(buf.Bytes()).ToSchema(opts...)
+}
+
+// unifyDataType widens a and b into a single type: matching types pass
+// through, int64+float64 promotes to float64, and anything else
+// conflicting falls back to string.
+func unifyDataType(a, b arrow.DataType) arrow.DataType {
+	if arrow.TypeEqual(a, b) {
+		return a
+	}
+
+	switch {
+	case a.ID() == arrow.INT64 && b.ID() == arrow.FLOAT64:
+		return arrow.PrimitiveTypes.Float64
+	case a.ID() == arrow.FLOAT64 && b.ID() == arrow.INT64:
+		return arrow.PrimitiveTypes.Float64
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// UnifySchemas merges two Arrow schemas inferred from different batches of
+// the same logical CSV. Fields present in both are widened with
+// unifyDataType; a field present in only one side is carried over as
+// nullable, since the rows on the other side omitted it.
+func UnifySchemas(a, b *arrow.Schema) *arrow.Schema {
+	aFields := make(map[string]arrow.Field, a.NumFields())
+	for _, f := range a.Fields() {
+		aFields[f.Name] = f
+	}
+
+	bFields := make(map[string]arrow.Field, b.NumFields())
+	for _, f := range b.Fields() {
+		bFields[f.Name] = f
+	}
+
+	order := make([]string, 0, len(aFields)+len(bFields))
+	seen := make(map[string]struct{}, len(aFields)+len(bFields))
+	for _, f := range a.Fields() {
+		order = append(order, f.Name)
+		seen[f.Name] = struct{}{}
+	}
+	for _, f := range b.Fields() {
+		if _, ok := seen[f.Name]; !ok {
+			order = append(order, f.Name)
+		}
+	}
+
+	fields := make([]arrow.Field, 0, len(order))
+	for _, name := range order {
+		af, aok := aFields[name]
+		bf, bok := bFields[name]
+
+		switch {
+		case aok && bok:
+			fields = append(fields, arrow.Field{
+				Name:     name,
+				Type:     unifyDataType(af.Type, bf.Type),
+				Nullable: af.Nullable || bf.Nullable,
+			})
+		case aok:
+			fields = append(fields, arrow.Field{Name: name, Type: af.Type, Nullable: true})
+		default:
+			fields = append(fields, arrow.Field{Name: name, Type: bf.Type, Nullable: true})
+		}
+	}
+
+	return arrow.NewSchema(fields, nil)
+}
@@ -0,0 +1,62 @@
+//go:build simdjson
+
+package json2schema
+
+import (
+	"errors"
+
+	simdjson "github.com/minio/simdjson-go"
+)
+
+var ErrUnexpectedSimdJsonRoot error = errors.New("unexpected simdjson root type")
+
+// SimdJsonDecoder implements JsonDecoder on top of minio/simdjson-go's
+// SIMD-accelerated parser. It walks the parsed tape straight into a
+// JsonMapObject, bypassing encoding/json's reflection-based Unmarshal;
+// this is the backend to plug into MapToCsv for large JSON inputs.
+type SimdJsonDecoder struct{}
+
+func (SimdJsonDecoder) Decode(j JsonRawObject) (JsonMapObject, error) {
+	parsed, e := simdjson.Parse([]byte(j), nil)
+	if nil != e {
+		return nil, e
+	}
+
+	iter := parsed.Iter()
+	var tmp simdjson.Iter
+
+	for {
+		typ := iter.Advance()
+
+		switch typ {
+		case simdjson.TypeRoot:
+			rootTyp, rootIter, e := iter.Root(&tmp)
+			if nil != e {
+				return nil, e
+			}
+			if simdjson.TypeObject != rootTyp {
+				return nil, ErrUnexpectedSimdJsonRoot
+			}
+
+			obj, e := rootIter.Object(nil)
+			if nil != e {
+				return nil, e
+			}
+
+			m, e := obj.Map(nil)
+			if nil != e {
+				return nil, e
+			}
+
+			return JsonMapObject(m), nil
+		case simdjson.TypeNone:
+			return nil, ErrNoJsonObjectsGot
+		}
+	}
+}
+
+// SimdJsonStreamDecoder drives the streaming pipeline (JSON array / NDJSON
+// input) using SimdJsonDecoder per object via JsonDecoderStream, so the
+// SIMD backend can be plugged into MapToStrings.ToJsonStreamToCsvUsing,
+// the path main.run actually calls.
+var SimdJsonStreamDecoder JsonStreamDecoder = JsonDecoderStream(SimdJsonDecoder{})
@@ -0,0 +1,61 @@
+//go:build simdjson
+
+package json2schema
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func bigJsonObject(n int) JsonRawObject {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i := 0; i < n; i++ {
+		if 0 != i {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, `"field%d":%d`, i, i)
+	}
+	buf.WriteByte('}')
+	return JsonRawObject(buf.Bytes())
+}
+
+func TestSimdJsonDecoderMatchesStdDecoder(t *testing.T) {
+	raw := bigJsonObject(16)
+
+	want, e := StdJsonDecoder.Decode(raw)
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	got, e := SimdJsonDecoder{}.Decode(raw)
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	if len(want) != len(got) {
+		t.Fatalf("expected %d fields, got %d", len(want), len(got))
+	}
+}
+
+func BenchmarkStdJsonDecoder(b *testing.B) {
+	raw := bigJsonObject(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, e := StdJsonDecoder.Decode(raw); nil != e {
+			b.Fatal(e)
+		}
+	}
+}
+
+func BenchmarkSimdJsonDecoder(b *testing.B) {
+	raw := bigJsonObject(1000)
+	dec := SimdJsonDecoder{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, e := dec.Decode(raw); nil != e {
+			b.Fatal(e)
+		}
+	}
+}
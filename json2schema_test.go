@@ -0,0 +1,220 @@
+package json2schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	ac "github.com/apache/arrow-go/v18/arrow/csv"
+)
+
+func sortedStrategy() MapToStrings {
+	return MapToStrings{
+		MapToHeaderStrings: MapToHeaderStrsSorted,
+		MapToValueStrings:  MapToValueStrsSorted,
+	}
+}
+
+func TestToJsonStreamToCsvUnionHeader(t *testing.T) {
+	input := `[{"a":1,"b":"x"},{"a":2,"b":"y","c":true}]`
+
+	csvData, e := sortedStrategy().ToJsonStreamToCsv()(strings.NewReader(input))
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	header := string(csvData.HeaderLine)
+	if !strings.Contains(header, "c") {
+		t.Fatalf("expected header to contain column c, got %q", header)
+	}
+
+	rows := string(csvData.Row1st)
+	if strings.Count(rows, "\n") != 2 {
+		t.Fatalf("expected 2 data rows, got %q", rows)
+	}
+}
+
+func TestCsvForSchemaRowsWithHeaderToSchemaUnifiesTypes(t *testing.T) {
+	input := `[{"a":1},{"a":1.5}]`
+
+	csvData, e := sortedStrategy().ToJsonStreamToCsv()(strings.NewReader(input))
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	var buf []byte
+	buf = append(buf, csvData.HeaderLine...)
+	buf = append(buf, csvData.Row1st...)
+
+	schema, e := CsvForSchemaRowsWithHeader(buf).ToSchema()
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	field, ok := schema.FieldsByName("a")
+	if !ok || 0 == len(field) {
+		t.Fatalf("expected field a in schema %v", schema)
+	}
+	if arrow.FLOAT64 != field[0].Type.ID() {
+		t.Fatalf("expected a to widen to float64, got %s", field[0].Type)
+	}
+}
+
+func TestCsvForSchemaRowsWithHeaderToSchemaNullableWhenRowOmitsColumn(t *testing.T) {
+	input := `[{"a":1,"b":2},{"a":3}]`
+
+	csvData, e := sortedStrategy().ToJsonStreamToCsv()(strings.NewReader(input))
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	var buf []byte
+	buf = append(buf, csvData.HeaderLine...)
+	buf = append(buf, csvData.Row1st...)
+
+	schema, e := CsvForSchemaRowsWithHeader(buf).ToSchema()
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	aField, ok := schema.FieldsByName("a")
+	if !ok || 0 == len(aField) {
+		t.Fatalf("expected field a in schema %v", schema)
+	}
+	if arrow.INT64 != aField[0].Type.ID() {
+		t.Fatalf("expected a to stay int64, got %s", aField[0].Type)
+	}
+
+	bField, ok := schema.FieldsByName("b")
+	if !ok || 0 == len(bField) {
+		t.Fatalf("expected field b in schema %v", schema)
+	}
+	if arrow.INT64 != bField[0].Type.ID() {
+		t.Fatalf("expected b to stay int64 despite the missing row, got %s", bField[0].Type)
+	}
+	if !bField[0].Nullable {
+		t.Fatal("expected b to be nullable since one row omits it")
+	}
+}
+
+func TestCsvForSchemaRowsWithHeaderToSchemaKeepsAlwaysBlankColumn(t *testing.T) {
+	input := `[{"a":1,"c":""},{"a":2,"c":""}]`
+
+	csvData, e := sortedStrategy().ToJsonStreamToCsv()(strings.NewReader(input))
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	var buf []byte
+	buf = append(buf, csvData.HeaderLine...)
+	buf = append(buf, csvData.Row1st...)
+
+	schema, e := CsvForSchemaRowsWithHeader(buf).ToSchema()
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	cField, ok := schema.FieldsByName("c")
+	if !ok || 0 == len(cField) {
+		t.Fatalf("expected an always-blank column c to still appear in schema %v", schema)
+	}
+	if !cField[0].Nullable {
+		t.Fatal("expected c to be nullable")
+	}
+}
+
+func TestCsvForSchemaRowsWithHeaderToSchemaConflictFallsBackToString(t *testing.T) {
+	input := `[{"a":1},{"a":"x"}]`
+
+	csvData, e := sortedStrategy().ToJsonStreamToCsv()(strings.NewReader(input))
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	var buf []byte
+	buf = append(buf, csvData.HeaderLine...)
+	buf = append(buf, csvData.Row1st...)
+
+	schema, e := CsvForSchemaRowsWithHeader(buf).ToSchema()
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	field, ok := schema.FieldsByName("a")
+	if !ok || 0 == len(field) {
+		t.Fatalf("expected field a in schema %v", schema)
+	}
+	if arrow.STRING != field[0].Type.ID() {
+		t.Fatalf("expected a to fall back to string on conflict, got %s", field[0].Type)
+	}
+}
+
+func TestCsvForSchemaRowsWithHeaderToSchemaSurfacesParseErrors(t *testing.T) {
+	buf := []byte("a\nnot-a-number\n")
+
+	_, e := CsvForSchemaRowsWithHeader(buf).ToSchema(
+		ac.WithColumnTypes(map[string]arrow.DataType{"a": arrow.PrimitiveTypes.Int64}),
+	)
+	if nil == e {
+		t.Fatal("expected a parse error, got nil")
+	}
+}
+
+func TestMapToHeaderStrsFlattenedNestedObjectAndArray(t *testing.T) {
+	obj := JsonMapObject{
+		"user": JsonMapObject{"name": "alice", "age": 30.0},
+		"tags": []any{"a", "b"},
+	}
+
+	headers, e := MapToHeaderStrsFlattened(DefaultFlattenOptions())(obj)
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	want := []string{"tags[0]", "tags[1]", "user.age", "user.name"}
+	if len(headers) != len(want) {
+		t.Fatalf("expected headers %v, got %v", want, headers)
+	}
+	for i, h := range want {
+		if headers[i] != h {
+			t.Fatalf("expected headers %v, got %v", want, headers)
+		}
+	}
+}
+
+func TestJsonDecoderStreamDrivesArbitraryDecoder(t *testing.T) {
+	input := `[{"a":1},{"a":2}]`
+
+	objs, e := JsonDecoderStream(StdJsonDecoder).DecodeStream(strings.NewReader(input))
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+	if 2 != len(objs) {
+		t.Fatalf("expected 2 objects, got %d", len(objs))
+	}
+	if objs[0]["a"] != 1.0 || objs[1]["a"] != 2.0 {
+		t.Fatalf("unexpected decoded objects: %v", objs)
+	}
+}
+
+func TestMapToValueStrsFlattenedMatchesHeaderOrder(t *testing.T) {
+	strategy := NewMapToStringsFlattened(DefaultFlattenOptions())
+
+	obj := JsonMapObject{"user": JsonMapObject{"name": "alice"}}
+
+	headers, e := strategy.MapToHeaderStrings(obj)
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+	vals, e := strategy.MapToValueStrings(obj)
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	if len(headers) != 1 || headers[0] != "user.name" {
+		t.Fatalf("expected [user.name], got %v", headers)
+	}
+	if len(vals) != 1 || vals[0] != "alice" {
+		t.Fatalf("expected [alice], got %v", vals)
+	}
+}
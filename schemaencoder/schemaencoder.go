@@ -0,0 +1,273 @@
+// Package schemaencoder turns an inferred *arrow.Schema into one of
+// several output formats (pretty JSON, Arrow IPC, Parquet, JSON Schema),
+// and lets callers register their own encoders alongside the built-ins.
+package schemaencoder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+)
+
+var ErrUnknownFormat error = errors.New("unknown schema output format")
+
+// SchemaEncoder serializes an Arrow schema into some output format.
+// Implementations encode schema metadata only (Arrow IPC header, JSON
+// Schema) or materialize an otherwise-empty file that carries the schema
+// (Parquet).
+type SchemaEncoder interface {
+	EncodeSchema(schema *arrow.Schema, w io.Writer) error
+}
+
+// SchemaEncoderFunc adapts a plain function to a SchemaEncoder.
+type SchemaEncoderFunc func(*arrow.Schema, io.Writer) error
+
+func (f SchemaEncoderFunc) EncodeSchema(schema *arrow.Schema, w io.Writer) error {
+	return f(schema, w)
+}
+
+// Registry maps a -format flag value to the SchemaEncoder that handles
+// it. Downstream users can Register their own encoders alongside the
+// built-ins returned by NewRegistry.
+type Registry map[string]SchemaEncoder
+
+// NewRegistry returns a Registry preloaded with the built-in encoders:
+// "json" (pretty JSON), "ipc" (Arrow IPC schema message), "parquet" (an
+// empty Parquet file carrying the schema), and "json-schema" (JSON Schema
+// draft-2020-12).
+func NewRegistry() Registry {
+	return Registry{
+		"json":        SchemaEncoderFunc(EncodePrettyJSON),
+		"ipc":         SchemaEncoderFunc(EncodeArrowIPC),
+		"parquet":     SchemaEncoderFunc(EncodeParquet),
+		"json-schema": SchemaEncoderFunc(EncodeJSONSchema),
+	}
+}
+
+func (r Registry) Register(format string, enc SchemaEncoder) {
+	r[format] = enc
+}
+
+func (r Registry) Encode(format string, schema *arrow.Schema, w io.Writer) error {
+	enc, ok := r[format]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+	return enc.EncodeSchema(schema, w)
+}
+
+// SerializableField is the JSON-friendly mirror of an arrow.Field,
+// covering all Arrow primitive types plus List, Struct, Timestamp, and
+// Decimal instead of defaulting everything unknown to utf8.
+type SerializableField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable"`
+
+	// Item describes a List's element type.
+	Item *SerializableField `json:"item,omitempty"`
+
+	// Fields describes a Struct's child fields.
+	Fields []SerializableField `json:"fields,omitempty"`
+
+	// Precision/Scale apply to Decimal128/Decimal256.
+	Precision int32 `json:"precision,omitempty"`
+	Scale     int32 `json:"scale,omitempty"`
+
+	// Unit/Timezone apply to Timestamp.
+	Unit     string `json:"unit,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+type SerializableSchema struct {
+	Fields []SerializableField `json:"fields"`
+}
+
+// ToSerializableSchema converts schema into its JSON-friendly mirror.
+func ToSerializableSchema(schema *arrow.Schema) *SerializableSchema {
+	fields := make([]SerializableField, schema.NumFields())
+	for i, field := range schema.Fields() {
+		fields[i] = toSerializableField(field)
+	}
+	return &SerializableSchema{Fields: fields}
+}
+
+func toSerializableField(field arrow.Field) SerializableField {
+	switch dt := field.Type.(type) {
+	case *arrow.Int8Type, *arrow.Int16Type, *arrow.Int32Type, *arrow.Int64Type,
+		*arrow.Uint8Type, *arrow.Uint16Type, *arrow.Uint32Type, *arrow.Uint64Type,
+		*arrow.Float32Type, *arrow.Float64Type, *arrow.BooleanType:
+		return SerializableField{Name: field.Name, Type: field.Type.Name(), Nullable: field.Nullable}
+	case *arrow.StringType, *arrow.LargeStringType:
+		return SerializableField{Name: field.Name, Type: "utf8", Nullable: field.Nullable}
+	case *arrow.TimestampType:
+		return SerializableField{
+			Name:     field.Name,
+			Type:     "timestamp",
+			Nullable: field.Nullable,
+			Unit:     dt.Unit.String(),
+			Timezone: dt.TimeZone,
+		}
+	case *arrow.Decimal128Type:
+		return SerializableField{
+			Name:      field.Name,
+			Type:      "decimal128",
+			Nullable:  field.Nullable,
+			Precision: dt.Precision,
+			Scale:     dt.Scale,
+		}
+	case *arrow.Decimal256Type:
+		return SerializableField{
+			Name:      field.Name,
+			Type:      "decimal256",
+			Nullable:  field.Nullable,
+			Precision: dt.Precision,
+			Scale:     dt.Scale,
+		}
+	case *arrow.ListType:
+		item := toSerializableField(dt.ElemField())
+		return SerializableField{Name: field.Name, Type: "list", Nullable: field.Nullable, Item: &item}
+	case *arrow.StructType:
+		children := make([]SerializableField, dt.NumFields())
+		for i, child := range dt.Fields() {
+			children[i] = toSerializableField(child)
+		}
+		return SerializableField{Name: field.Name, Type: "struct", Nullable: field.Nullable, Fields: children}
+	default:
+		return SerializableField{Name: field.Name, Type: field.Type.Name(), Nullable: field.Nullable}
+	}
+}
+
+// EncodePrettyJSON writes schema as indented JSON via SerializableSchema.
+func EncodePrettyJSON(schema *arrow.Schema, w io.Writer) error {
+	b, e := json.MarshalIndent(ToSerializableSchema(schema), "", "  ")
+	if nil != e {
+		return e
+	}
+	_, e = w.Write(b)
+	return e
+}
+
+// EncodeArrowIPC writes an Arrow IPC stream carrying only the schema
+// message (no record batches).
+func EncodeArrowIPC(schema *arrow.Schema, w io.Writer) error {
+	wtr := ipc.NewWriter(w, ipc.WithSchema(schema), ipc.WithAllocator(memory.DefaultAllocator))
+	return wtr.Close()
+}
+
+// EncodeParquet writes an otherwise-empty Parquet file carrying schema.
+func EncodeParquet(schema *arrow.Schema, w io.Writer) error {
+	fw, e := pqarrow.NewFileWriter(
+		schema,
+		w,
+		parquet.NewWriterProperties(),
+		pqarrow.DefaultWriterProps(),
+	)
+	if nil != e {
+		return e
+	}
+	return fw.Close()
+}
+
+// jsonSchemaDoc is a JSON Schema draft-2020-12 document.
+type jsonSchemaDoc struct {
+	Schema     string                    `json:"$schema"`
+	Type       string                    `json:"type"`
+	Properties map[string]jsonSchemaProp `json:"properties"`
+	Required   []string                  `json:"required,omitempty"`
+}
+
+type jsonSchemaProp struct {
+	Type       any                       `json:"type"`
+	Format     string                    `json:"format,omitempty"`
+	Items      *jsonSchemaProp           `json:"items,omitempty"`
+	Properties map[string]jsonSchemaProp `json:"properties,omitempty"`
+	Required   []string                  `json:"required,omitempty"`
+}
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// EncodeJSONSchema writes schema as a JSON Schema draft-2020-12 document,
+// with nested object/array for Struct/List fields.
+func EncodeJSONSchema(schema *arrow.Schema, w io.Writer) error {
+	doc := jsonSchemaDoc{
+		Schema:     jsonSchemaDraft,
+		Type:       "object",
+		Properties: make(map[string]jsonSchemaProp, schema.NumFields()),
+	}
+
+	for _, field := range schema.Fields() {
+		doc.Properties[field.Name] = toJSONSchemaProp(field)
+		if !field.Nullable {
+			doc.Required = append(doc.Required, field.Name)
+		}
+	}
+
+	b, e := json.MarshalIndent(doc, "", "  ")
+	if nil != e {
+		return e
+	}
+	_, e = w.Write(b)
+	return e
+}
+
+func toJSONSchemaProp(field arrow.Field) jsonSchemaProp {
+	jsType, format := jsonSchemaTypeOf(field.Type)
+
+	var typ any = jsType
+	if field.Nullable {
+		typ = []string{jsType, "null"}
+	}
+
+	prop := jsonSchemaProp{Type: typ, Format: format}
+
+	switch dt := field.Type.(type) {
+	case *arrow.ListType:
+		item := toJSONSchemaProp(dt.ElemField())
+		prop.Items = &item
+	case *arrow.LargeListType:
+		item := toJSONSchemaProp(dt.ElemField())
+		prop.Items = &item
+	case *arrow.StructType:
+		prop.Properties = make(map[string]jsonSchemaProp, dt.NumFields())
+		for _, child := range dt.Fields() {
+			prop.Properties[child.Name] = toJSONSchemaProp(child)
+			if !child.Nullable {
+				prop.Required = append(prop.Required, child.Name)
+			}
+		}
+	}
+
+	return prop
+}
+
+func jsonSchemaTypeOf(dt arrow.DataType) (string, string) {
+	switch dt.(type) {
+	case *arrow.Int8Type, *arrow.Int16Type, *arrow.Int32Type, *arrow.Int64Type,
+		*arrow.Uint8Type, *arrow.Uint16Type, *arrow.Uint32Type, *arrow.Uint64Type:
+		return "integer", ""
+	case *arrow.Float32Type, *arrow.Float64Type:
+		return "number", ""
+	case *arrow.BooleanType:
+		return "boolean", ""
+	case *arrow.StringType, *arrow.LargeStringType:
+		return "string", ""
+	case *arrow.TimestampType:
+		return "string", "date-time"
+	case *arrow.Decimal128Type, *arrow.Decimal256Type:
+		return "string", ""
+	case *arrow.ListType, *arrow.LargeListType:
+		return "array", ""
+	case *arrow.StructType:
+		return "object", ""
+	default:
+		return "string", ""
+	}
+}
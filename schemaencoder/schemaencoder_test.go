@@ -0,0 +1,123 @@
+package schemaencoder
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+func testSchema() *arrow.Schema {
+	return arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64, Nullable: false},
+		{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+}
+
+func TestRegistryEncodeUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewRegistry().Encode("bogus", testSchema(), &buf)
+	if nil == e {
+		t.Fatal("expected an error for an unknown format")
+	}
+	if !strings.Contains(e.Error(), "bogus") {
+		t.Fatalf("expected error to mention the format name, got %v", e)
+	}
+}
+
+func TestRegistryEncodePrettyJSON(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewRegistry().Encode("json", testSchema(), &buf)
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	var got SerializableSchema
+	if e := json.Unmarshal(buf.Bytes(), &got); nil != e {
+		t.Fatalf("output is not valid JSON: %v", e)
+	}
+	if 2 != len(got.Fields) {
+		t.Fatalf("expected 2 fields, got %d", len(got.Fields))
+	}
+	if "id" != got.Fields[0].Name || "int64" != got.Fields[0].Type || got.Fields[0].Nullable {
+		t.Fatalf("unexpected id field: %+v", got.Fields[0])
+	}
+	if "name" != got.Fields[1].Name || "utf8" != got.Fields[1].Type || !got.Fields[1].Nullable {
+		t.Fatalf("unexpected name field: %+v", got.Fields[1])
+	}
+}
+
+func TestRegistryEncodeArrowIPC(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewRegistry().Encode("ipc", testSchema(), &buf)
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+	if 0 == buf.Len() {
+		t.Fatal("expected non-empty IPC output")
+	}
+}
+
+func TestRegistryEncodeParquet(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewRegistry().Encode("parquet", testSchema(), &buf)
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("PAR1")) {
+		t.Fatalf("expected a Parquet file (PAR1 magic), got %q", buf.Bytes()[:4])
+	}
+}
+
+func TestRegistryEncodeJSONSchema(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewRegistry().Encode("json-schema", testSchema(), &buf)
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	var doc jsonSchemaDoc
+	if e := json.Unmarshal(buf.Bytes(), &doc); nil != e {
+		t.Fatalf("output is not valid JSON: %v", e)
+	}
+	if jsonSchemaDraft != doc.Schema {
+		t.Fatalf("expected $schema %q, got %q", jsonSchemaDraft, doc.Schema)
+	}
+
+	idProp, ok := doc.Properties["id"]
+	if !ok {
+		t.Fatal("expected an id property")
+	}
+	if "integer" != idProp.Type {
+		t.Fatalf("expected id type integer, got %v", idProp.Type)
+	}
+
+	var requiresID bool
+	for _, name := range doc.Required {
+		if "id" == name {
+			requiresID = true
+		}
+	}
+	if !requiresID {
+		t.Fatalf("expected id to be required, got required=%v", doc.Required)
+	}
+}
+
+func TestRegistryRegisterOverridesBuiltin(t *testing.T) {
+	r := NewRegistry()
+	r.Register("json", SchemaEncoderFunc(func(schema *arrow.Schema, w io.Writer) error {
+		_, e := w.Write([]byte("custom"))
+		return e
+	}))
+
+	var buf bytes.Buffer
+	if e := r.Encode("json", testSchema(), &buf); nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+	if "custom" != buf.String() {
+		t.Fatalf("expected custom encoder output, got %q", buf.String())
+	}
+}
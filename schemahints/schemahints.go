@@ -0,0 +1,167 @@
+// Package schemahints lets a user steer schema inference with a
+// JSON-Schema-style per-field hints document: concrete Arrow types
+// (including timestamp formats and decimal precision/scale) feed into
+// arrow/csv.WithColumnTypes, while rename/drop/nullable/required/enum
+// constraints - everything Arrow's CSV reader can't express - are applied
+// to the inferred *arrow.Schema afterwards.
+package schemahints
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+var ErrUnknownHintType error = errors.New("unknown hint type")
+
+// FieldHint describes how one column's inferred schema should be
+// overridden or constrained.
+type FieldHint struct {
+	// Type is one of: string, float64, int64, bool, timestamp, decimal.
+	Type string `json:"type,omitempty"`
+
+	// Format applies to Type "timestamp": "date-time" (default), "date",
+	// or "time".
+	Format string `json:"format,omitempty"`
+
+	// Precision/Scale apply to Type "decimal".
+	Precision int32 `json:"precision,omitempty"`
+	Scale     int32 `json:"scale,omitempty"`
+
+	// Nullable overrides the inferred nullability when set.
+	Nullable *bool `json:"nullable,omitempty"`
+
+	// Required forces Nullable to false; it wins over Nullable.
+	Required bool `json:"required,omitempty"`
+
+	// Enum records the allowed values as field metadata; Arrow has no
+	// native enum type.
+	Enum []string `json:"enum,omitempty"`
+
+	// Rename replaces the column name in the final schema.
+	Rename string `json:"rename,omitempty"`
+
+	// Drop removes the column from the final schema entirely.
+	Drop bool `json:"drop,omitempty"`
+}
+
+// DataType resolves the hint's Type/Format/Precision/Scale into a concrete
+// arrow.DataType, or (nil, nil) if the hint doesn't specify a type.
+func (h FieldHint) DataType() (arrow.DataType, error) {
+	switch h.Type {
+	case "":
+		return nil, nil
+	case "string", "utf8":
+		return arrow.BinaryTypes.String, nil
+	case "int64":
+		return arrow.PrimitiveTypes.Int64, nil
+	case "float64":
+		return arrow.PrimitiveTypes.Float64, nil
+	case "bool", "boolean":
+		return arrow.FixedWidthTypes.Boolean, nil
+	case "timestamp":
+		return h.timestampType(), nil
+	case "decimal":
+		return &arrow.Decimal128Type{Precision: h.Precision, Scale: h.Scale}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownHintType, h.Type)
+	}
+}
+
+func (h FieldHint) timestampType() arrow.DataType {
+	switch h.Format {
+	case "date":
+		return arrow.FixedWidthTypes.Date32
+	case "time":
+		return arrow.FixedWidthTypes.Time64ns
+	default:
+		return &arrow.TimestampType{Unit: arrow.Microsecond}
+	}
+}
+
+// Document is the top-level shape of a -hints file: one FieldHint per
+// original (pre-rename) column name.
+type Document struct {
+	Fields map[string]FieldHint `json:"fields"`
+}
+
+// Load reads a Document from r.
+func Load(r io.Reader) (Document, error) {
+	var doc Document
+	e := json.NewDecoder(r).Decode(&doc)
+	return doc, e
+}
+
+// ToColumnTypes translates every hint with a concrete Arrow type into the
+// column_name -> arrow.DataType map understood by arrow/csv.WithColumnTypes.
+func (doc Document) ToColumnTypes() (map[string]arrow.DataType, error) {
+	types := make(map[string]arrow.DataType, len(doc.Fields))
+	for name, hint := range doc.Fields {
+		dt, e := hint.DataType()
+		if nil != e {
+			return nil, e
+		}
+		if nil != dt {
+			types[name] = dt
+		}
+	}
+	return types, nil
+}
+
+// Apply rewrites schema according to doc: dropping fields marked Drop,
+// renaming fields with Rename set, overriding Nullable/Required, and
+// attaching Enum as field metadata. Fields schema carries but doc doesn't
+// mention pass through unchanged.
+func (doc Document) Apply(schema *arrow.Schema) (*arrow.Schema, error) {
+	fields := make([]arrow.Field, 0, schema.NumFields())
+
+	for _, field := range schema.Fields() {
+		hint, ok := doc.Fields[field.Name]
+		if !ok {
+			fields = append(fields, field)
+			continue
+		}
+		if hint.Drop {
+			continue
+		}
+
+		applied, e := hint.apply(field)
+		if nil != e {
+			return nil, e
+		}
+		fields = append(fields, applied)
+	}
+
+	return arrow.NewSchema(fields, nil), nil
+}
+
+func (h FieldHint) apply(field arrow.Field) (arrow.Field, error) {
+	if "" != h.Rename {
+		field.Name = h.Rename
+	}
+
+	dt, e := h.DataType()
+	if nil != e {
+		return field, e
+	}
+	if nil != dt {
+		field.Type = dt
+	}
+
+	if nil != h.Nullable {
+		field.Nullable = *h.Nullable
+	}
+	if h.Required {
+		field.Nullable = false
+	}
+
+	if 0 < len(h.Enum) {
+		field.Metadata = arrow.NewMetadata([]string{"enum"}, []string{strings.Join(h.Enum, ",")})
+	}
+
+	return field, nil
+}
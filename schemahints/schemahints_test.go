@@ -0,0 +1,65 @@
+package schemahints
+
+import (
+	"strings"
+	"testing"
+
+	json2schema "github.com/takanoriyanagitani/go-arrow-json2csv2schema"
+	ac "github.com/apache/arrow-go/v18/arrow/csv"
+)
+
+func TestDocumentApplyIntHintParses(t *testing.T) {
+	doc, e := Load(strings.NewReader(`{"fields":{"a":{"type":"int64","required":true}}}`))
+	if nil != e {
+		t.Fatalf("unexpected error loading hints: %v", e)
+	}
+
+	types, e := doc.ToColumnTypes()
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	strategy := json2schema.MapToStrings{
+		MapToHeaderStrings: json2schema.MapToHeaderStrsSorted,
+		MapToValueStrings:  json2schema.MapToValueStrsSorted,
+	}
+
+	csvData, e := strategy.ToJsonStreamToCsv()(strings.NewReader(`{"a":1}`))
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+
+	var buf []byte
+	buf = append(buf, csvData.HeaderLine...)
+	buf = append(buf, csvData.Row1st...)
+
+	schema, e := json2schema.CsvForSchemaRowsWithHeader(buf).ToSchema(ac.WithColumnTypes(types))
+	if nil != e {
+		t.Fatalf("int64 hint should parse cleanly, got error: %v", e)
+	}
+
+	schema, e = doc.Apply(schema)
+	if nil != e {
+		t.Fatalf("unexpected error applying hints: %v", e)
+	}
+
+	field, ok := schema.FieldsByName("a")
+	if !ok || 0 == len(field) || field[0].Nullable {
+		t.Fatalf("expected required, non-nullable field a, got %v", schema)
+	}
+}
+
+func TestToColumnTypesIgnoresDropAndRenameOnlyHints(t *testing.T) {
+	doc := Document{Fields: map[string]FieldHint{
+		"old": {Rename: "new"},
+		"gone": {Drop: true},
+	}}
+
+	types, e := doc.ToColumnTypes()
+	if nil != e {
+		t.Fatalf("unexpected error: %v", e)
+	}
+	if 0 != len(types) {
+		t.Fatalf("expected no column types from rename/drop-only hints, got %v", types)
+	}
+}